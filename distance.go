@@ -0,0 +1,118 @@
+package mercantile
+
+import "math"
+
+// Tiles returns the tiles at zoom that cover bbox, by snapping its
+// corners to tile indices and enumerating everything between them.
+func Tiles(bbox Bbox, zoom int) []Tile {
+	ul := tileFromLngLat(bbox.MinX, bbox.MaxY, zoom)
+	lr := tileFromLngLat(bbox.MaxX, bbox.MinY, zoom)
+
+	var tiles []Tile
+	for x := ul.X; x <= lr.X; x++ {
+		for y := ul.Y; y <= lr.Y; y++ {
+			tiles = append(tiles, Tile{X: x, Y: y, Z: zoom})
+		}
+	}
+
+	return tiles
+}
+
+// Haversine returns the great-circle distance in meters between two
+// lng/lat points on the WGS84 sphere.
+func Haversine(lng1, lat1, lng2, lat2 float64) float64 {
+	lat1Rad := degToRadians(lat1)
+	lat2Rad := degToRadians(lat2)
+	dLat := degToRadians(lat2 - lat1)
+	dLng := degToRadians(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1Rad)*math.Cos(lat2Rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return RE * c
+}
+
+// BboxAround returns the lng/lat bounding box(es) reaching radiusMeters
+// from lng, lat. Latitude expansion is clamped to [-90, 90]. When the
+// radius pushes the box past the antimeridian, it is split into two
+// boxes, one on either side of it — which is why this returns []Bbox
+// rather than a single Bbox: a lone box cannot represent both sides of
+// the split, so callers should range over the result instead of assuming
+// len == 1.
+func BboxAround(lng, lat, radiusMeters float64) []Bbox {
+	latDelta := radToDegrees(radiusMeters / RE)
+
+	lngScale := math.Cos(degToRadians(lat))
+	if lngScale < 1e-9 {
+		lngScale = 1e-9
+	}
+	lngDelta := radToDegrees(radiusMeters / (RE * lngScale))
+
+	minLat := math.Max(lat-latDelta, -90)
+	maxLat := math.Min(lat+latDelta, 90)
+
+	if lngDelta >= 180 {
+		// Close enough to a pole that the radius spans every longitude.
+		return []Bbox{{MinX: -180, MinY: minLat, MaxX: 180, MaxY: maxLat}}
+	}
+
+	minLng := lng - lngDelta
+	maxLng := lng + lngDelta
+
+	if minLng < -180 {
+		return []Bbox{
+			{MinX: -180, MinY: minLat, MaxX: maxLng, MaxY: maxLat},
+			{MinX: minLng + 360, MinY: minLat, MaxX: 180, MaxY: maxLat},
+		}
+	}
+	if maxLng > 180 {
+		return []Bbox{
+			{MinX: minLng, MinY: minLat, MaxX: 180, MaxY: maxLat},
+			{MinX: -180, MinY: minLat, MaxX: maxLng - 360, MaxY: maxLat},
+		}
+	}
+
+	return []Bbox{{MinX: minLng, MinY: minLat, MaxX: maxLng, MaxY: maxLat}}
+}
+
+// TilesWithinDistance returns the tiles at zoom whose nearest edge or
+// corner lies within radiusMeters of lng, lat. Note that web mercator
+// tiles, like the ones XyBounds/Bounds describe, don't extend past
+// +/-85.0511 degrees latitude (the projection is undefined at the
+// poles), so for a query closer to a pole than that the distance is
+// measured to the tiles' +/-85.0511 edge, and tiles are returned only
+// once radiusMeters reaches that edge.
+func TilesWithinDistance(lng, lat, radiusMeters float64, zoom int) []Tile {
+	var result []Tile
+	seen := map[Tile]bool{}
+
+	for _, bbox := range BboxAround(lng, lat, radiusMeters) {
+		for _, tile := range Tiles(bbox, zoom) {
+			if seen[tile] {
+				continue
+			}
+			seen[tile] = true
+
+			bounds := Bounds(tile)
+			nearestLng := clamp(lng, bounds.MinX, bounds.MaxX)
+			nearestLat := clamp(lat, bounds.MinY, bounds.MaxY)
+
+			if Haversine(lng, lat, nearestLng, nearestLat) <= radiusMeters {
+				result = append(result, tile)
+			}
+		}
+	}
+
+	return result
+}
+
+func clamp(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,84 @@
+package mercantile
+
+import "testing"
+
+func TestTilesCoversBbox(t *testing.T) {
+	bbox := Bbox{-9.140625, 53.12040528310657, -8.7890625, 53.330872983017045}
+
+	tiles := Tiles(bbox, 10)
+
+	AssertEqual(t, len(tiles) > 0, true)
+	for _, tile := range tiles {
+		AssertEqual(t, tile.Z, 10)
+	}
+}
+
+func TestHaversineZeroDistance(t *testing.T) {
+	d := Haversine(-9.140625, 53.33087298301705, -9.140625, 53.33087298301705)
+
+	AssertEqual(t, d, 0.0)
+}
+
+func TestHaversineKnownDistance(t *testing.T) {
+	// London to Paris, roughly 344 km.
+	d := Haversine(-0.1276, 51.5072, 2.3522, 48.8566)
+
+	if d < 330000 || d > 360000 {
+		t.Errorf("expected ~344km, got %v meters", d)
+	}
+}
+
+func TestBboxAroundClampsLatitude(t *testing.T) {
+	bboxes := BboxAround(0, 89.9, 500000)
+
+	AssertEqual(t, len(bboxes), 1)
+	AssertEqual(t, bboxes[0].MaxY, 90.0)
+}
+
+func TestBboxAroundSplitsAtAntimeridian(t *testing.T) {
+	bboxes := BboxAround(179.9, 0, 50000)
+
+	AssertEqual(t, len(bboxes), 2)
+	AssertEqual(t, bboxes[0].MaxX, 180.0)
+	AssertEqual(t, bboxes[1].MinX, -180.0)
+}
+
+func TestTilesCoversWholeGlobe(t *testing.T) {
+	tiles := Tiles(Bbox{MinX: -180, MinY: -90, MaxX: 180, MaxY: 90}, 4)
+
+	AssertEqual(t, len(tiles), 256)
+}
+
+func TestTilesWithinDistanceNearSouthPole(t *testing.T) {
+	// -84 is within web mercator's +/-85.0511 range, so tiles near the
+	// pole's row should come back, not the empty result a south-pole
+	// tile aliased onto the north pole's row would produce.
+	tiles := TilesWithinDistance(0, -84, 200000, 6)
+
+	AssertEqual(t, len(tiles) > 0, true)
+	for _, tile := range tiles {
+		if tile.Y < 59 {
+			t.Errorf("expected tile %v near the south pole's row, got y=%d", tile, tile.Y)
+		}
+	}
+}
+
+func TestTilesWithinDistanceContainsOrigin(t *testing.T) {
+	lng, lat := -9.140625, 53.33087298301705
+	zoom := 10
+
+	tiles := TilesWithinDistance(lng, lat, 5000, zoom)
+
+	origin := tileFromLngLat(lng, lat, zoom)
+
+	found := false
+	for _, tile := range tiles {
+		if tile == origin {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Errorf("expected tile %v containing the origin point to be included", origin)
+	}
+}
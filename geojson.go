@@ -0,0 +1,231 @@
+package mercantile
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+)
+
+var lngKeys = []string{"lng", "lon", "longitude"}
+var latKeys = []string{"lat", "latitude"}
+
+// FromGeoJSON parses a GeoJSON Point, Polygon, Feature, or
+// FeatureCollection document and returns the tiles covering each geometry
+// at its "zoom" (or "z") property: one tile for a Point, and the full
+// Tiles coverage of its extent for a Polygon. It also accepts
+// loosely-structured objects that give coordinates directly via
+// lon/lng/longitude and lat/latitude style keys instead of a GeoJSON
+// geometry.
+func FromGeoJSON(data []byte) ([]Tile, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	return tilesFromGeoJSONValue(raw)
+}
+
+func tilesFromGeoJSONValue(v map[string]interface{}) ([]Tile, error) {
+	switch t, _ := v["type"].(string); t {
+	case "FeatureCollection":
+		features, _ := v["features"].([]interface{})
+		var tiles []Tile
+		for _, f := range features {
+			fm, ok := f.(map[string]interface{})
+			if !ok {
+				return nil, errors.New("mercantile: invalid feature in FeatureCollection")
+			}
+			ts, err := tilesFromGeoJSONValue(fm)
+			if err != nil {
+				return nil, err
+			}
+			tiles = append(tiles, ts...)
+		}
+		return tiles, nil
+
+	case "Feature":
+		geom, _ := v["geometry"].(map[string]interface{})
+		return tileFromFeatureValue(v, geom)
+
+	case "Point", "Polygon":
+		return tileFromFeatureValue(v, v)
+
+	default:
+		// struct-style object: lng/lat (+ zoom) given directly as keys
+		return tileFromFeatureValue(v, v)
+	}
+}
+
+func tileFromFeatureValue(properties, geom map[string]interface{}) ([]Tile, error) {
+	zoom, err := extractZoom(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	// A Polygon can span more than one tile, so compute its coverage via
+	// Tiles instead of collapsing it to a single representative point.
+	if geomType, _ := geom["type"].(string); geomType == "Polygon" {
+		bbox, err := bboxFromPolygon(geom)
+		if err != nil {
+			return nil, err
+		}
+		return Tiles(bbox, zoom), nil
+	}
+
+	lng, lat, err := lngLatFromGeometry(geom)
+	if err != nil {
+		return nil, err
+	}
+	return []Tile{tileFromLngLat(lng, lat, zoom)}, nil
+}
+
+func extractZoom(v map[string]interface{}) (int, error) {
+	if props, ok := v["properties"].(map[string]interface{}); ok {
+		if z, ok := floatKey(props, "zoom", "z"); ok {
+			return int(z), nil
+		}
+	}
+	if z, ok := floatKey(v, "zoom", "z"); ok {
+		return int(z), nil
+	}
+	return 0, errors.New("mercantile: missing zoom property")
+}
+
+func lngLatFromGeometry(geom map[string]interface{}) (lng, lat float64, err error) {
+	switch t, _ := geom["type"].(string); t {
+	case "Point":
+		coords, ok := geom["coordinates"].([]interface{})
+		if !ok || len(coords) < 2 {
+			return 0, 0, errors.New("mercantile: invalid Point coordinates")
+		}
+		lng, _ = coords[0].(float64)
+		lat, _ = coords[1].(float64)
+		return lng, lat, nil
+
+	default:
+		return lngLatFromKeys(geom)
+	}
+}
+
+// bboxFromPolygon returns the lng/lat extent of a Polygon geometry's
+// exterior ring, for coverage via Tiles.
+func bboxFromPolygon(geom map[string]interface{}) (Bbox, error) {
+	rings, ok := geom["coordinates"].([]interface{})
+	if !ok || len(rings) == 0 {
+		return Bbox{}, errors.New("mercantile: invalid Polygon coordinates")
+	}
+	ring, ok := rings[0].([]interface{})
+	if !ok || len(ring) == 0 {
+		return Bbox{}, errors.New("mercantile: invalid Polygon coordinates")
+	}
+
+	minLng, minLat := math.Inf(1), math.Inf(1)
+	maxLng, maxLat := math.Inf(-1), math.Inf(-1)
+
+	for _, p := range ring {
+		point, ok := p.([]interface{})
+		if !ok || len(point) < 2 {
+			return Bbox{}, errors.New("mercantile: invalid Polygon coordinates")
+		}
+		pLng, _ := point[0].(float64)
+		pLat, _ := point[1].(float64)
+
+		minLng = math.Min(minLng, pLng)
+		maxLng = math.Max(maxLng, pLng)
+		minLat = math.Min(minLat, pLat)
+		maxLat = math.Max(maxLat, pLat)
+	}
+
+	return Bbox{MinX: minLng, MinY: minLat, MaxX: maxLng, MaxY: maxLat}, nil
+}
+
+func lngLatFromKeys(m map[string]interface{}) (lng, lat float64, err error) {
+	lngVal, ok := floatKey(m, lngKeys...)
+	if !ok {
+		return 0, 0, errors.New("mercantile: missing longitude key")
+	}
+	latVal, ok := floatKey(m, latKeys...)
+	if !ok {
+		return 0, 0, errors.New("mercantile: missing latitude key")
+	}
+	return lngVal, latVal, nil
+}
+
+func floatKey(m map[string]interface{}, keys ...string) (float64, bool) {
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			if f, ok := v.(float64); ok {
+				return f, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// tileFromLngLat returns the XYZ tile containing lng, lat at zoom.
+func tileFromLngLat(lng, lat float64, zoom int) Tile {
+	Z2 := math.Pow(2, float64(zoom))
+
+	x := int(math.Floor((lng + 180.0) / 360.0 * Z2))
+
+	maxIndex := int(Z2) - 1
+
+	// The Mercator y-formula's log term goes to ±Inf exactly at the
+	// poles, which overflows the float64->int conversion below to Go's
+	// min-int sentinel; clamp to the first/last row before that happens
+	// instead of relying on the clamp at the bottom to catch it (it
+	// can't tell a min-int overflow from the south pole apart).
+	var y int
+	if lat >= 90 {
+		y = 0
+	} else if lat <= -90 {
+		y = maxIndex
+	} else {
+		sinLat := math.Sin(degToRadians(lat))
+		y = int(math.Floor((0.5 - math.Log((1+sinLat)/(1-sinLat))/(4*math.Pi)) * Z2))
+	}
+
+	if x < 0 {
+		x = 0
+	} else if x > maxIndex {
+		x = maxIndex
+	}
+	if y < 0 {
+		y = 0
+	} else if y > maxIndex {
+		y = maxIndex
+	}
+
+	return Tile{X: x, Y: y, Z: zoom}
+}
+
+// ToGeoJSON returns bbox as a GeoJSON Polygon feature covering its extent.
+func (bbox *Bbox) ToGeoJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "Feature",
+		"bbox": []float64{bbox.MinX, bbox.MinY, bbox.MaxX, bbox.MaxY},
+		"geometry": map[string]interface{}{
+			"type": "Polygon",
+			"coordinates": [][][]float64{{
+				{bbox.MinX, bbox.MinY},
+				{bbox.MaxX, bbox.MinY},
+				{bbox.MaxX, bbox.MaxY},
+				{bbox.MinX, bbox.MaxY},
+				{bbox.MinX, bbox.MinY},
+			}},
+		},
+		"properties": map[string]interface{}{},
+	}
+}
+
+// ToGeoJSONFeature returns tile's lng/lat bounds as a GeoJSON Polygon
+// feature, with its x, y, z carried as properties.
+func (tile Tile) ToGeoJSONFeature() map[string]interface{} {
+	bounds := Bounds(tile)
+	feature := bounds.ToGeoJSON()
+	feature["properties"] = map[string]interface{}{
+		"x": tile.X,
+		"y": tile.Y,
+		"z": tile.Z,
+	}
+	return feature
+}
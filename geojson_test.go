@@ -0,0 +1,132 @@
+package mercantile
+
+import (
+	"testing"
+)
+
+func TestFromGeoJSONPoint(t *testing.T) {
+	data := []byte(`{"type": "Point", "coordinates": [-9.140625, 53.33087298301705], "properties": {"zoom": 10}}`)
+
+	tiles, err := FromGeoJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AssertEqual(t, len(tiles), 1)
+	AssertEqual(t, tiles[0], Tile{486, 332, 10})
+}
+
+func TestTileFromLngLatPolesDoNotAlias(t *testing.T) {
+	north := tileFromLngLat(0, 90, 4)
+	south := tileFromLngLat(0, -90, 4)
+
+	AssertEqual(t, north, Tile{8, 0, 4})
+	AssertEqual(t, south, Tile{8, 15, 4})
+}
+
+func TestFromGeoJSONFeature(t *testing.T) {
+	data := []byte(`{
+		"type": "Feature",
+		"properties": {"zoom": 10},
+		"geometry": {"type": "Point", "coordinates": [-9.140625, 53.33087298301705]}
+	}`)
+
+	tiles, err := FromGeoJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AssertEqual(t, tiles[0], Tile{486, 332, 10})
+}
+
+func TestFromGeoJSONFeatureCollection(t *testing.T) {
+	data := []byte(`{
+		"type": "FeatureCollection",
+		"features": [
+			{"type": "Feature", "properties": {"zoom": 10}, "geometry": {"type": "Point", "coordinates": [-9.140625, 53.33087298301705]}},
+			{"type": "Feature", "properties": {"z": 10}, "geometry": {"type": "Point", "coordinates": [-9.140625, 53.33087298301705]}}
+		]
+	}`)
+
+	tiles, err := FromGeoJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AssertEqual(t, len(tiles), 2)
+	AssertEqual(t, tiles[0], tiles[1])
+}
+
+func TestFromGeoJSONPolygonCoversMultipleTiles(t *testing.T) {
+	data := []byte(`{
+		"type": "Polygon",
+		"properties": {"zoom": 4},
+		"coordinates": [[
+			[-40, -40], [40, -40], [40, 40], [-40, 40], [-40, -40]
+		]]
+	}`)
+
+	tiles, err := FromGeoJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := Tiles(Bbox{MinX: -40, MinY: -40, MaxX: 40, MaxY: 40}, 4)
+
+	AssertEqual(t, len(tiles), len(expected))
+	if len(tiles) <= 1 {
+		t.Errorf("expected the polygon to span more than one tile, got %v", tiles)
+	}
+}
+
+func TestFromGeoJSONStructStyleKeys(t *testing.T) {
+	data := []byte(`{"lng": -9.140625, "lat": 53.33087298301705, "zoom": 10}`)
+
+	tiles, err := FromGeoJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AssertEqual(t, tiles[0], Tile{486, 332, 10})
+}
+
+func TestFromGeoJSONVariantLngLatKeys(t *testing.T) {
+	data := []byte(`{"longitude": -9.140625, "latitude": 53.33087298301705, "z": 10}`)
+
+	tiles, err := FromGeoJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AssertEqual(t, tiles[0], Tile{486, 332, 10})
+}
+
+func TestFromGeoJSONMissingZoomErrors(t *testing.T) {
+	data := []byte(`{"type": "Point", "coordinates": [-9.140625, 53.33087298301705]}`)
+
+	_, err := FromGeoJSON(data)
+	if err == nil {
+		t.Error("expected an error for a missing zoom property")
+	}
+}
+
+func TestBbox_ToGeoJSON(t *testing.T) {
+	bbox := Bbox{-9.140625, 53.12040528310657, -8.7890625, 53.330872983017045}
+
+	feature := bbox.ToGeoJSON()
+
+	AssertEqual(t, feature["type"], "Feature")
+	geometry := feature["geometry"].(map[string]interface{})
+	AssertEqual(t, geometry["type"], "Polygon")
+}
+
+func TestTile_ToGeoJSONFeature(t *testing.T) {
+	tile := Tile{486, 332, 10}
+
+	feature := tile.ToGeoJSONFeature()
+
+	properties := feature["properties"].(map[string]interface{})
+	AssertEqual(t, properties["x"], 486)
+	AssertEqual(t, properties["y"], 332)
+	AssertEqual(t, properties["z"], 10)
+}
@@ -66,31 +66,43 @@ func Bounds(tile Tile) Bbox {
 	return Bbox{minLngDeg, minLatDeg, maxLngDeg, maxLatDeg}
 }
 
-// Xy Convert longitude, latitude to web mercator x, y
-func Xy(lng, lat float64) (x, y float64) {
-	x = RE * degToRadians(lng)
-
-	if lat <= -90 {
-		y = math.Inf(0)
-	} else if lat >= 90 {
-		y = math.Inf(1)
-	} else {
-		y = RE * math.Log(math.Tan((math.Pi*0.25)+(0.5*degToRadians(lat))))
-	}
+// Xy Convert longitude, latitude to x, y in the given projection. projection
+// defaults to web mercator (EPSG:3857) when omitted.
+func Xy(lng, lat float64, projection ...Projection) (x, y float64) {
+	return resolveProjection(projection).Forward(lng, lat)
+}
 
-	return
+// LngLat Convert x, y in the given projection back to longitude, latitude.
+// projection defaults to web mercator (EPSG:3857) when omitted.
+func LngLat(x, y float64, projection ...Projection) (lng, lat float64) {
+	return resolveProjection(projection).Inverse(x, y)
 }
 
-// LngLat Convert web mercator x, y to longitude and latitude
-func LngLat(x, y float64) (lng, lat float64) {
-	lng = x * R2D / RE
-	lat = ((math.Pi * 0.5) - 2.0*math.Atan(math.Exp(-y/RE))) * R2D
+// XyBounds Get the bounding box of a tile in the given projection's
+// coordinates. projection defaults to web mercator (EPSG:3857) when
+// omitted, using a closed-form tile-size formula; other projections
+// project the tile's lng/lat corners (see Bounds) and take their extent.
+func XyBounds(tile Tile, projection ...Projection) Bbox {
+	proj := resolveProjection(projection)
+	if proj == WebMercator {
+		return webMercatorXyBounds(tile)
+	}
 
-	return
+	bounds := Bounds(tile)
+	x0, y0 := proj.Forward(bounds.MinX, bounds.MinY)
+	x1, y1 := proj.Forward(bounds.MinX, bounds.MaxY)
+	x2, y2 := proj.Forward(bounds.MaxX, bounds.MinY)
+	x3, y3 := proj.Forward(bounds.MaxX, bounds.MaxY)
+
+	return Bbox{
+		MinX: math.Min(math.Min(x0, x1), math.Min(x2, x3)),
+		MinY: math.Min(math.Min(y0, y1), math.Min(y2, y3)),
+		MaxX: math.Max(math.Max(x0, x1), math.Max(x2, x3)),
+		MaxY: math.Max(math.Max(y0, y1), math.Max(y2, y3)),
+	}
 }
 
-// XyBounds Get the web mercator bounding box of a tile
-func XyBounds(tile Tile) Bbox {
+func webMercatorXyBounds(tile Tile) Bbox {
 	tileSize := CE / math.Pow(2, float64(tile.Z))
 	minX := float64(tile.X)*tileSize - CE/2
 	maxX := minX + tileSize
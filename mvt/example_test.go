@@ -0,0 +1,19 @@
+package mvt_test
+
+import (
+	"net/http"
+
+	"github.com/MarcelCode/mercantile"
+	"github.com/MarcelCode/mercantile/mvt"
+)
+
+// ExampleHandler shows how to wire mvt.Handler into an HTTP server so that
+// "/{z}/{x}/{y}.mvt" requests are served as gzipped vector tiles.
+func ExampleHandler() {
+	source := func(tile mercantile.Tile) ([]mvt.Feature, error) {
+		// Look up whatever overlaps tile and return it as features.
+		return nil, nil
+	}
+
+	http.Handle("/tiles/", http.StripPrefix("/tiles/", mvt.Handler(source, "layer", mvt.DefaultExtent)))
+}
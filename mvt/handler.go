@@ -0,0 +1,61 @@
+package mvt
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MarcelCode/mercantile"
+)
+
+// FeatureSource supplies the features to encode for a given tile, e.g. by
+// querying a spatial index or database.
+type FeatureSource func(tile mercantile.Tile) ([]Feature, error)
+
+// Handler serves "/{z}/{x}/{y}.mvt" requests by encoding whatever
+// FeatureSource returns for the requested tile. extent is passed through
+// to Encode (zero selects DefaultExtent).
+func Handler(source FeatureSource, layerName string, extent int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tile, ok := tileFromPath(r.URL.Path)
+		if !ok {
+			http.Error(w, "invalid tile path, expected /{z}/{x}/{y}.mvt", http.StatusBadRequest)
+			return
+		}
+
+		features, err := source(tile)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		body, err := Encode(features, tile, layerName, extent)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.mapbox-vector-tile")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(body)
+	}
+}
+
+// tileFromPath parses the trailing "/{z}/{x}/{y}.mvt" segment of a request
+// path into a mercantile.Tile.
+func tileFromPath(path string) (mercantile.Tile, bool) {
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "/"), ".mvt")
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 {
+		return mercantile.Tile{}, false
+	}
+
+	z, errZ := strconv.Atoi(parts[0])
+	x, errX := strconv.Atoi(parts[1])
+	y, errY := strconv.Atoi(parts[2])
+	if errZ != nil || errX != nil || errY != nil {
+		return mercantile.Tile{}, false
+	}
+
+	return mercantile.Tile{X: x, Y: y, Z: z}, true
+}
@@ -0,0 +1,329 @@
+// Package mvt encodes Tile features into the Mapbox Vector Tile (MVT)
+// protobuf wire format, ready to gzip and serve over HTTP.
+package mvt
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/MarcelCode/mercantile"
+)
+
+// DefaultExtent is the number of integer units a tile is divided into
+// along each axis when no extent is specified.
+const DefaultExtent = 4096
+
+// GeomType identifies the kind of geometry a Feature holds.
+type GeomType int
+
+const (
+	GeomPoint GeomType = iota
+	GeomLineString
+	GeomPolygon
+)
+
+// CoordSpace identifies the coordinate space Feature.Coordinates is given
+// in.
+type CoordSpace int
+
+const (
+	// LngLat is WGS84 degrees, the zero value and default.
+	LngLat CoordSpace = iota
+	// WebMercator is EPSG:3857 meters, as returned by mercantile.Xy.
+	WebMercator
+)
+
+// Feature is a single geometry to be encoded into a vector tile layer.
+// Coordinates holds one or more rings (lines, for LineString; rings, for
+// Polygon; or a single ring of points, for Point/MultiPoint), each a
+// sequence of coordinate pairs in Space (lng/lat degrees by default, or
+// pre-projected web-mercator meters).
+type Feature struct {
+	Type        GeomType
+	Space       CoordSpace
+	Coordinates [][][2]float64
+	Properties  map[string]interface{}
+}
+
+// MVT command ids, see
+// https://github.com/mapbox/vector-tile-spec/tree/master/2.1
+const (
+	cmdMoveTo    = 1
+	cmdLineTo    = 2
+	cmdClosePath = 7
+)
+
+func commandInteger(id, count int) uint32 {
+	return uint32((id & 0x7) | (count << 3))
+}
+
+func zigZag(v int32) uint32 {
+	return uint32((v << 1) ^ (v >> 31))
+}
+
+func zigZag64(v int64) uint64 {
+	return uint64((v << 1) ^ (v >> 63))
+}
+
+// Encode projects features into tile-relative integer space, encodes them
+// as a single-layer MVT Tile message, and returns the gzipped protobuf
+// bytes. extent defaults to DefaultExtent when zero or negative.
+func Encode(features []Feature, tile mercantile.Tile, layerName string, extent int) ([]byte, error) {
+	if extent <= 0 {
+		extent = DefaultExtent
+	}
+
+	bounds := mercantile.XyBounds(tile)
+	spanX := bounds.MaxX - bounds.MinX
+	spanY := bounds.MaxY - bounds.MinY
+	if spanX == 0 || spanY == 0 {
+		return nil, errors.New("mvt: degenerate tile bounds")
+	}
+
+	project := func(a, b float64, space CoordSpace) [2]int32 {
+		x, y := a, b
+		if space == LngLat {
+			x, y = mercantile.Xy(a, b)
+		}
+		px := (x - bounds.MinX) / spanX * float64(extent)
+		py := (bounds.MaxY - y) / spanY * float64(extent)
+		return [2]int32{int32(math.Round(px)), int32(math.Round(py))}
+	}
+
+	var keys []string
+	keyIndex := map[string]int{}
+	var values []value
+	valueIndex := map[value]int{}
+
+	var featureBufs [][]byte
+	for _, f := range features {
+		rings := make([][][2]int32, len(f.Coordinates))
+		for i, ring := range f.Coordinates {
+			pts := make([][2]int32, len(ring))
+			for j, c := range ring {
+				pts[j] = project(c[0], c[1], f.Space)
+			}
+			rings[i] = pts
+		}
+		geometry := encodeGeometry(f.Type, rings)
+
+		var tags []uint32
+		for k, v := range f.Properties {
+			ki, ok := keyIndex[k]
+			if !ok {
+				ki = len(keys)
+				keys = append(keys, k)
+				keyIndex[k] = ki
+			}
+			val := toValue(v)
+			vi, ok := valueIndex[val]
+			if !ok {
+				vi = len(values)
+				values = append(values, val)
+				valueIndex[val] = vi
+			}
+			tags = append(tags, uint32(ki), uint32(vi))
+		}
+
+		featureBufs = append(featureBufs, encodeFeature(tags, geomType(f.Type), geometry))
+	}
+
+	layer := encodeLayer(layerName, extent, featureBufs, keys, values)
+	tileBuf := appendLengthDelimited(nil, 3, layer)
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	if _, err := w.Write(tileBuf); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return gz.Bytes(), nil
+}
+
+// encodeGeometry converts tile-relative integer coordinates into MVT
+// MoveTo/LineTo/ClosePath commands with delta+zigzag encoded parameters.
+func encodeGeometry(g GeomType, rings [][][2]int32) []uint32 {
+	var out []uint32
+	var cx, cy int32
+
+	if g == GeomPoint {
+		if len(rings) == 0 {
+			return out
+		}
+		pts := rings[0]
+		out = append(out, commandInteger(cmdMoveTo, len(pts)))
+		for _, p := range pts {
+			dx, dy := p[0]-cx, p[1]-cy
+			cx, cy = p[0], p[1]
+			out = append(out, zigZag(dx), zigZag(dy))
+		}
+		return out
+	}
+
+	for _, ring := range rings {
+		if len(ring) == 0 {
+			continue
+		}
+
+		out = append(out, commandInteger(cmdMoveTo, 1))
+		dx, dy := ring[0][0]-cx, ring[0][1]-cy
+		cx, cy = ring[0][0], ring[0][1]
+		out = append(out, zigZag(dx), zigZag(dy))
+
+		lineTo := ring[1:]
+		if g == GeomPolygon && len(lineTo) > 0 && lineTo[len(lineTo)-1] == ring[0] {
+			lineTo = lineTo[:len(lineTo)-1]
+		}
+
+		if len(lineTo) > 0 {
+			out = append(out, commandInteger(cmdLineTo, len(lineTo)))
+			for _, p := range lineTo {
+				dx, dy := p[0]-cx, p[1]-cy
+				cx, cy = p[0], p[1]
+				out = append(out, zigZag(dx), zigZag(dy))
+			}
+		}
+
+		if g == GeomPolygon {
+			out = append(out, commandInteger(cmdClosePath, 1))
+		}
+	}
+
+	return out
+}
+
+func geomType(g GeomType) uint32 {
+	switch g {
+	case GeomPoint:
+		return 1
+	case GeomLineString:
+		return 2
+	case GeomPolygon:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// value is a deduplicated entry in a layer's value table.
+type value struct {
+	kind byte // 's' string, 'f' float64, 'i' int64, 'b' bool
+	s    string
+	f    float64
+	i    int64
+	b    bool
+}
+
+func toValue(v interface{}) value {
+	switch t := v.(type) {
+	case string:
+		return value{kind: 's', s: t}
+	case bool:
+		return value{kind: 'b', b: t}
+	case int:
+		return value{kind: 'i', i: int64(t)}
+	case int64:
+		return value{kind: 'i', i: t}
+	case float32:
+		return value{kind: 'f', f: float64(t)}
+	case float64:
+		return value{kind: 'f', f: t}
+	default:
+		return value{kind: 's', s: fmt.Sprintf("%v", t)}
+	}
+}
+
+func encodeValue(v value) []byte {
+	var buf []byte
+	switch v.kind {
+	case 's':
+		buf = appendLengthDelimited(buf, 1, []byte(v.s))
+	case 'f':
+		buf = appendFixed64Field(buf, 3, math.Float64bits(v.f))
+	case 'i':
+		buf = appendVarintField(buf, 6, zigZag64(v.i))
+	case 'b':
+		b := uint64(0)
+		if v.b {
+			b = 1
+		}
+		buf = appendVarintField(buf, 7, b)
+	}
+	return buf
+}
+
+func encodeFeature(tags []uint32, geomType uint32, geometry []uint32) []byte {
+	var buf []byte
+	if len(tags) > 0 {
+		buf = appendPackedVarints(buf, 2, tags)
+	}
+	buf = appendVarintField(buf, 3, uint64(geomType))
+	if len(geometry) > 0 {
+		buf = appendPackedVarints(buf, 4, geometry)
+	}
+	return buf
+}
+
+func encodeLayer(name string, extent int, featureBufs [][]byte, keys []string, values []value) []byte {
+	var buf []byte
+	buf = appendVarintField(buf, 15, 1) // version
+	buf = appendLengthDelimited(buf, 1, []byte(name))
+	for _, fb := range featureBufs {
+		buf = appendLengthDelimited(buf, 2, fb)
+	}
+	for _, k := range keys {
+		buf = appendLengthDelimited(buf, 3, []byte(k))
+	}
+	for _, v := range values {
+		buf = appendLengthDelimited(buf, 4, encodeValue(v))
+	}
+	buf = appendVarintField(buf, 5, uint64(extent))
+	return buf
+}
+
+// --- minimal protobuf wire helpers (no external dependency) ---
+
+func appendVarint(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+func appendTag(buf []byte, fieldNum, wireType int) []byte {
+	return appendVarint(buf, uint64(fieldNum<<3|wireType))
+}
+
+func appendVarintField(buf []byte, fieldNum int, v uint64) []byte {
+	buf = appendTag(buf, fieldNum, 0)
+	return appendVarint(buf, v)
+}
+
+func appendFixed64Field(buf []byte, fieldNum int, bits uint64) []byte {
+	buf = appendTag(buf, fieldNum, 1)
+	for i := 0; i < 8; i++ {
+		buf = append(buf, byte(bits))
+		bits >>= 8
+	}
+	return buf
+}
+
+func appendLengthDelimited(buf []byte, fieldNum int, data []byte) []byte {
+	buf = appendTag(buf, fieldNum, 2)
+	buf = appendVarint(buf, uint64(len(data)))
+	return append(buf, data...)
+}
+
+func appendPackedVarints(buf []byte, fieldNum int, vals []uint32) []byte {
+	var packed []byte
+	for _, v := range vals {
+		packed = appendVarint(packed, uint64(v))
+	}
+	return appendLengthDelimited(buf, fieldNum, packed)
+}
@@ -0,0 +1,108 @@
+package mvt
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/MarcelCode/mercantile"
+)
+
+func AssertEqual(t *testing.T, a interface{}, b interface{}) {
+	if reflect.DeepEqual(a, b) {
+		return
+	}
+	t.Errorf("Received %v (type %v), expected %v (type %v)", a, reflect.TypeOf(a), b, reflect.TypeOf(b))
+}
+
+func TestCommandInteger(t *testing.T) {
+	AssertEqual(t, commandInteger(cmdMoveTo, 1), uint32(9))
+	AssertEqual(t, commandInteger(cmdLineTo, 3), uint32(26))
+	AssertEqual(t, commandInteger(cmdClosePath, 1), uint32(15))
+}
+
+func TestZigZag(t *testing.T) {
+	AssertEqual(t, zigZag(0), uint32(0))
+	AssertEqual(t, zigZag(-1), uint32(1))
+	AssertEqual(t, zigZag(1), uint32(2))
+	AssertEqual(t, zigZag(-2), uint32(3))
+}
+
+func TestEncodeGeometryPolygonClosesPath(t *testing.T) {
+	ring := [][2]int32{{0, 0}, {10, 0}, {10, 10}, {0, 10}}
+	geometry := encodeGeometry(GeomPolygon, [][][2]int32{ring})
+
+	// MoveTo(1), dx, dy, LineTo(3), ... x3, ClosePath(1)
+	AssertEqual(t, geometry[0], commandInteger(cmdMoveTo, 1))
+	AssertEqual(t, geometry[3], commandInteger(cmdLineTo, 3))
+	AssertEqual(t, geometry[len(geometry)-1], commandInteger(cmdClosePath, 1))
+}
+
+func TestEncodeProducesValidGzip(t *testing.T) {
+	tile := mercantile.Tile{X: 486, Y: 332, Z: 10}
+	features := []Feature{
+		{
+			Type:        GeomPoint,
+			Coordinates: [][][2]float64{{{-9.0, 53.2}}},
+			Properties:  map[string]interface{}{"name": "lough corrib"},
+		},
+		{
+			Type: GeomPolygon,
+			Coordinates: [][][2]float64{{
+				{-9.2, 53.1}, {-9.0, 53.1}, {-9.0, 53.3}, {-9.2, 53.1},
+			}},
+			Properties: map[string]interface{}{"name": "county", "area": 5},
+		},
+	}
+
+	data, err := Encode(features, tile, "points", DefaultExtent)
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("output is not valid gzip: %v", err)
+	}
+	defer r.Close()
+
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed decompressing tile: %v", err)
+	}
+	if len(raw) == 0 {
+		t.Error("decoded tile payload is empty")
+	}
+}
+
+func TestEncodeWebMercatorSpaceMatchesLngLat(t *testing.T) {
+	tile := mercantile.Tile{X: 486, Y: 332, Z: 10}
+	lng, lat := -9.0, 53.2
+	x, y := mercantile.Xy(lng, lat)
+
+	lngLatData, err := Encode([]Feature{{Type: GeomPoint, Coordinates: [][][2]float64{{{lng, lat}}}}}, tile, "points", DefaultExtent)
+	if err != nil {
+		t.Fatalf("Encode (LngLat) returned error: %v", err)
+	}
+
+	mercatorData, err := Encode([]Feature{{Type: GeomPoint, Space: WebMercator, Coordinates: [][][2]float64{{{x, y}}}}}, tile, "points", DefaultExtent)
+	if err != nil {
+		t.Fatalf("Encode (WebMercator) returned error: %v", err)
+	}
+
+	if !bytes.Equal(lngLatData, mercatorData) {
+		t.Error("expected equivalent lng/lat and web-mercator features to encode to the same tile")
+	}
+}
+
+func TestEncodeEmptyFeaturesStillProducesTile(t *testing.T) {
+	data, err := Encode(nil, mercantile.Tile{X: 0, Y: 0, Z: 0}, "layer", DefaultExtent)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) == 0 {
+		t.Error("expected non-empty gzipped output for an empty feature set")
+	}
+}
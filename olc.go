@@ -0,0 +1,186 @@
+package mercantile
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+// olcAlphabet is the 20-symbol Open Location Code alphabet, chosen to
+// avoid vowels and characters easily confused with digits.
+const olcAlphabet = "23456789CFGHJMPQRVWX"
+
+const (
+	olcBase              = 20
+	olcPaddingChar       = "0"
+	olcSeparator         = "+"
+	olcSeparatorPosition = 8
+	olcPairCodeLength    = 10
+	olcGridRows          = 4
+	olcGridCols          = 5
+	olcMaxCodeLength     = 15
+)
+
+// EncodeOLC returns the Open Location Code (Plus Code) for lng, lat with
+// codeLen significant digits (excluding the "+" separator). codeLen is
+// clamped to [2, 15] and defaults to the standard 10-digit pair code when
+// zero or negative.
+func EncodeOLC(lng, lat float64, codeLen int) string {
+	if codeLen <= 0 {
+		codeLen = olcPairCodeLength
+	}
+	if codeLen < 2 {
+		codeLen = 2
+	}
+	if codeLen > olcMaxCodeLength {
+		codeLen = olcMaxCodeLength
+	}
+
+	// Latitude isn't periodic like longitude: wrapping it modulo 180 would
+	// collapse the north pole onto the south pole's cell. Clamp it before
+	// the modular shift instead of after.
+	if lat >= 90 {
+		lat = math.Nextafter(180, 0)
+	} else {
+		lat = normalizeOLC(lat+90, 180)
+	}
+	lng = normalizeOLC(lng+180, 360)
+
+	var code []byte
+	latPlaceValue := 180.0
+	lngPlaceValue := 360.0
+
+	pairDigits := codeLen
+	if pairDigits > olcPairCodeLength {
+		pairDigits = olcPairCodeLength
+	}
+
+	for i := 0; i < pairDigits; i += 2 {
+		latPlaceValue /= olcBase
+		digit := int(lat / latPlaceValue)
+		lat -= float64(digit) * latPlaceValue
+		code = append(code, olcAlphabet[digit])
+
+		if i+1 < pairDigits {
+			lngPlaceValue /= olcBase
+			digit = int(lng / lngPlaceValue)
+			lng -= float64(digit) * lngPlaceValue
+			code = append(code, olcAlphabet[digit])
+		}
+	}
+
+	for len(code) < olcSeparatorPosition {
+		code = append(code, olcPaddingChar[0])
+	}
+	code = append(code[:olcSeparatorPosition], append([]byte(olcSeparator), code[olcSeparatorPosition:]...)...)
+
+	for i := olcPairCodeLength; i < codeLen; i++ {
+		latPlaceValue /= olcGridRows
+		lngPlaceValue /= olcGridCols
+
+		row := int(lat / latPlaceValue)
+		col := int(lng / lngPlaceValue)
+
+		code = append(code, olcAlphabet[row*olcGridCols+col])
+
+		lat -= float64(row) * latPlaceValue
+		lng -= float64(col) * lngPlaceValue
+	}
+
+	return string(code)
+}
+
+// DecodeOLC returns the bounding box covered by code.
+func DecodeOLC(code string) Bbox {
+	code = strings.ToUpper(code)
+	code = strings.Replace(code, olcSeparator, "", 1)
+	code = strings.TrimRight(code, olcPaddingChar)
+
+	var lat, lng float64
+	latPlaceValue := 180.0
+	lngPlaceValue := 360.0
+
+	pairDigits := len(code)
+	if pairDigits > olcPairCodeLength {
+		pairDigits = olcPairCodeLength
+	}
+
+	for i := 0; i < pairDigits; i++ {
+		digit := strings.IndexByte(olcAlphabet, code[i])
+		if digit < 0 {
+			continue
+		}
+		if i%2 == 0 {
+			latPlaceValue /= olcBase
+			lat += float64(digit) * latPlaceValue
+		} else {
+			lngPlaceValue /= olcBase
+			lng += float64(digit) * lngPlaceValue
+		}
+	}
+
+	for i := olcPairCodeLength; i < len(code); i++ {
+		digit := strings.IndexByte(olcAlphabet, code[i])
+		if digit < 0 {
+			continue
+		}
+		latPlaceValue /= olcGridRows
+		lngPlaceValue /= olcGridCols
+
+		row := digit / olcGridCols
+		col := digit % olcGridCols
+		lat += float64(row) * latPlaceValue
+		lng += float64(col) * lngPlaceValue
+	}
+
+	minLat := lat - 90
+	minLng := lng - 180
+
+	return Bbox{MinX: minLng, MinY: minLat, MaxX: minLng + lngPlaceValue, MaxY: minLat + latPlaceValue}
+}
+
+// normalizeOLC wraps v into [0, placeValue) so codes wrap cleanly near the
+// poles and the antimeridian.
+func normalizeOLC(v, placeValue float64) float64 {
+	r := math.Remainder(v, placeValue)
+	if r < 0 {
+		r += placeValue
+	}
+	return r
+}
+
+// TileToOLC returns the Open Location Code covering tile's center point,
+// at a code length that grows with the tile's zoom level.
+func TileToOLC(tile Tile) string {
+	bounds := Bounds(tile)
+	centerLng := (bounds.MinX + bounds.MaxX) / 2
+	centerLat := (bounds.MinY + bounds.MaxY) / 2
+
+	return EncodeOLC(centerLng, centerLat, olcCodeLenForZoom(tile.Z))
+}
+
+// OLCToTile decodes code and returns the XYZ tile containing its center
+// point at zoom.
+func OLCToTile(code string, zoom int) (Tile, error) {
+	trimmed := strings.TrimRight(strings.Replace(code, olcSeparator, "", 1), olcPaddingChar)
+	if trimmed == "" {
+		return Tile{}, errors.New("mercantile: empty OLC code")
+	}
+
+	bbox := DecodeOLC(code)
+	centerLng := (bbox.MinX + bbox.MaxX) / 2
+	centerLat := (bbox.MinY + bbox.MaxY) / 2
+
+	return tileFromLngLat(centerLng, centerLat, zoom), nil
+}
+
+func olcCodeLenForZoom(zoom int) int {
+	codeLen := olcPairCodeLength + zoom - 10
+	if codeLen < 2 {
+		codeLen = 2
+	}
+	if codeLen > olcMaxCodeLength {
+		codeLen = olcMaxCodeLength
+	}
+	return codeLen
+}
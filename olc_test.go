@@ -0,0 +1,91 @@
+package mercantile
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncodeOLCFormat(t *testing.T) {
+	code := EncodeOLC(-9.140625, 53.33087298301705, 10)
+
+	AssertEqual(t, len(code), 11) // 8 digits + "+" + 2 digits
+	AssertEqual(t, strings.Contains(code, "+"), true)
+}
+
+func TestEncodeDecodeOLCRoundTrip(t *testing.T) {
+	lng, lat := -9.140625, 53.33087298301705
+
+	code := EncodeOLC(lng, lat, 10)
+	bbox := DecodeOLC(code)
+
+	if lng < bbox.MinX || lng > bbox.MaxX {
+		t.Errorf("decoded bbox %v does not contain lng %v", bbox, lng)
+	}
+	if lat < bbox.MinY || lat > bbox.MaxY {
+		t.Errorf("decoded bbox %v does not contain lat %v", bbox, lat)
+	}
+}
+
+func TestEncodeOLCWithGridRefinement(t *testing.T) {
+	lng, lat := 8.682222, 49.412222
+
+	code := EncodeOLC(lng, lat, 13)
+	bbox := DecodeOLC(code)
+
+	AssertEqual(t, len(code), 14) // 8 digits + "+" + 5 digits
+
+	if lng < bbox.MinX || lng > bbox.MaxX || lat < bbox.MinY || lat > bbox.MaxY {
+		t.Errorf("decoded bbox %v does not contain %v,%v", bbox, lng, lat)
+	}
+}
+
+func TestTileToOLCAndBack(t *testing.T) {
+	tile := Tile{486, 332, 10}
+
+	code := TileToOLC(tile)
+	got, err := OLCToTile(code, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AssertEqual(t, got, tile)
+}
+
+func TestOLCToTileEmptyCodeErrors(t *testing.T) {
+	_, err := OLCToTile("0000+", 10)
+	if err == nil {
+		t.Error("expected an error for an all-padding OLC code")
+	}
+}
+
+func TestEncodeOLCPolesDoNotCollapse(t *testing.T) {
+	north := EncodeOLC(0, 90, 10)
+	south := EncodeOLC(0, -90, 10)
+
+	if north == south {
+		t.Errorf("north and south pole codes must differ, both got %v", north)
+	}
+}
+
+func TestOLCToTileNearPoleDoesNotAlias(t *testing.T) {
+	code := EncodeOLC(0, -89.9, 10)
+
+	tile, err := OLCToTile(code, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AssertEqual(t, tile.Y, 15) // last row at zoom 4, not row 0
+}
+
+func TestEncodeOLCNearPoleWrapsCleanly(t *testing.T) {
+	code := EncodeOLC(0, 89.9999, 10)
+	bbox := DecodeOLC(code)
+
+	if bbox.MaxY > 90 {
+		t.Errorf("decoded bbox %v exceeds the north pole", bbox)
+	}
+	if 89.9999 < bbox.MinY || 89.9999 > bbox.MaxY {
+		t.Errorf("decoded bbox %v does not contain near-pole lat 89.9999", bbox)
+	}
+}
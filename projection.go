@@ -0,0 +1,274 @@
+package mercantile
+
+import "math"
+
+// Projection converts between longitude/latitude (in degrees) and a
+// projected x, y coordinate space, letting Xy, LngLat, and XyBounds drive
+// tiling schemes other than web mercator.
+type Projection interface {
+	Forward(lng, lat float64) (x, y float64)
+	Inverse(x, y float64) (lng, lat float64)
+}
+
+// WebMercator is the EPSG:3857 projection used by XYZ tiles, and the
+// default used throughout this package.
+var WebMercator Projection = webMercatorProjection{}
+
+// EPSG4326 is the plate carrée (equirectangular, WGS84 geographic)
+// projection: longitude and latitude scaled linearly by the earth's
+// radius, with no distortion correction.
+var EPSG4326 Projection = epsg4326Projection{}
+
+// EqualEarth is the Equal Earth projection (Šavrič, Jenny & Jenny, 2018),
+// an equal-area pseudocylindrical projection designed as a visually
+// pleasing alternative to Gall-Peters.
+var EqualEarth Projection = equalEarthProjection{}
+
+// Robinson is the Robinson projection, a pseudocylindrical compromise
+// projection built from a table of interpolated parallels rather than a
+// closed-form formula.
+var Robinson Projection = robinsonProjection{}
+
+// NaturalEarth is the Natural Earth projection (Tom Patterson, 2007), a
+// pseudocylindrical compromise projection tuned to minimize visual
+// distortion of landmasses at small scales.
+var NaturalEarth Projection = naturalEarthProjection{}
+
+func resolveProjection(projections []Projection) Projection {
+	if len(projections) > 0 && projections[0] != nil {
+		return projections[0]
+	}
+	return WebMercator
+}
+
+// webMercatorProjection implements EPSG:3857, matching the original Xy
+// and LngLat formulas exactly.
+type webMercatorProjection struct{}
+
+func (webMercatorProjection) Forward(lng, lat float64) (x, y float64) {
+	x = RE * degToRadians(lng)
+
+	if lat <= -90 {
+		y = math.Inf(0)
+	} else if lat >= 90 {
+		y = math.Inf(1)
+	} else {
+		y = RE * math.Log(math.Tan((math.Pi*0.25)+(0.5*degToRadians(lat))))
+	}
+
+	return
+}
+
+func (webMercatorProjection) Inverse(x, y float64) (lng, lat float64) {
+	lng = x * R2D / RE
+	lat = ((math.Pi * 0.5) - 2.0*math.Atan(math.Exp(-y/RE))) * R2D
+
+	return
+}
+
+type epsg4326Projection struct{}
+
+func (epsg4326Projection) Forward(lng, lat float64) (x, y float64) {
+	return RE * degToRadians(lng), RE * degToRadians(lat)
+}
+
+func (epsg4326Projection) Inverse(x, y float64) (lng, lat float64) {
+	return radToDegrees(x / RE), radToDegrees(y / RE)
+}
+
+const (
+	equalEarthA1 = 1.340264
+	equalEarthA2 = -0.081106
+	equalEarthA3 = 0.000893
+	equalEarthA4 = 0.003796
+)
+
+type equalEarthProjection struct{}
+
+func (equalEarthProjection) Forward(lng, lat float64) (x, y float64) {
+	lngRad := degToRadians(lng)
+	latRad := degToRadians(lat)
+
+	theta := math.Asin(math.Sqrt(3) / 2 * math.Sin(latRad))
+	theta2 := theta * theta
+
+	denom := 3 * (9*equalEarthA4*math.Pow(theta, 8) + 7*equalEarthA3*math.Pow(theta, 6) + 3*equalEarthA2*theta2 + equalEarthA1)
+	x = RE * (2 * math.Sqrt(3) * lngRad * math.Cos(theta)) / denom
+	y = RE * (equalEarthA4*math.Pow(theta, 9) + equalEarthA3*math.Pow(theta, 7) + equalEarthA2*math.Pow(theta, 3) + equalEarthA1*theta)
+
+	return
+}
+
+func (equalEarthProjection) Inverse(x, y float64) (lng, lat float64) {
+	yr := y / RE
+	theta := yr
+
+	const maxIterations = 12
+	for i := 0; i < maxIterations; i++ {
+		theta2 := theta * theta
+		fy := equalEarthA1*theta + equalEarthA2*math.Pow(theta, 3) + equalEarthA3*math.Pow(theta, 7) + equalEarthA4*math.Pow(theta, 9) - yr
+		fpy := equalEarthA1 + 3*equalEarthA2*theta2 + 7*equalEarthA3*math.Pow(theta, 6) + 9*equalEarthA4*math.Pow(theta, 8)
+		delta := fy / fpy
+		theta -= delta
+		if math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+
+	sinLat := 2 * math.Sin(theta) / math.Sqrt(3)
+	lat = radToDegrees(math.Asin(sinLat))
+
+	theta2 := theta * theta
+	denom := 3 * (9*equalEarthA4*math.Pow(theta, 8) + 7*equalEarthA3*math.Pow(theta, 6) + 3*equalEarthA2*theta2 + equalEarthA1)
+	lng = radToDegrees(x / RE * denom / (2 * math.Sqrt(3) * math.Cos(theta)))
+
+	return
+}
+
+// robinsonTable holds the standard Robinson projection parallels at 5°
+// increments from the equator (0°) to the pole (90°): x-scale, y-scale.
+var robinsonTable = [19][2]float64{
+	{1.0000, 0.0000},
+	{0.9986, 0.0620},
+	{0.9954, 0.1240},
+	{0.9900, 0.1860},
+	{0.9822, 0.2480},
+	{0.9730, 0.3100},
+	{0.9600, 0.3720},
+	{0.9427, 0.4340},
+	{0.9216, 0.4958},
+	{0.8962, 0.5571},
+	{0.8679, 0.6176},
+	{0.8350, 0.6769},
+	{0.7986, 0.7346},
+	{0.7597, 0.7903},
+	{0.7186, 0.8435},
+	{0.6732, 0.8936},
+	{0.6213, 0.9394},
+	{0.5722, 0.9761},
+	{0.5322, 1.0000},
+}
+
+const (
+	robinsonFXC = 0.8487
+	robinsonFYC = 1.3523
+)
+
+type robinsonProjection struct{}
+
+func (robinsonProjection) Forward(lng, lat float64) (x, y float64) {
+	xScale, yScale := robinsonInterpolate(math.Abs(lat))
+
+	x = RE * robinsonFXC * degToRadians(lng) * xScale
+	y = RE * robinsonFYC * yScale
+	if lat < 0 {
+		y = -y
+	}
+
+	return
+}
+
+func (robinsonProjection) Inverse(x, y float64) (lng, lat float64) {
+	absY := math.Abs(y) / (RE * robinsonFYC)
+
+	i := 1
+	for i < len(robinsonTable)-1 && robinsonTable[i][1] < absY {
+		i++
+	}
+
+	y0, y1 := robinsonTable[i-1][1], robinsonTable[i][1]
+	x0, x1 := robinsonTable[i-1][0], robinsonTable[i][0]
+
+	frac := 0.0
+	if y1 != y0 {
+		frac = (absY - y0) / (y1 - y0)
+	}
+
+	xScale := x0 + (x1-x0)*frac
+	latDeg := (float64(i-1) + frac) * 5
+	if y < 0 {
+		latDeg = -latDeg
+	}
+
+	lat = latDeg
+	lng = radToDegrees(x / (RE * robinsonFXC * xScale))
+
+	return
+}
+
+// robinsonInterpolate linearly interpolates the x/y scale factors from
+// robinsonTable for an absolute latitude in degrees.
+func robinsonInterpolate(absLatDeg float64) (xScale, yScale float64) {
+	if absLatDeg >= 90 {
+		last := robinsonTable[len(robinsonTable)-1]
+		return last[0], last[1]
+	}
+
+	pos := absLatDeg / 5
+	i := int(pos)
+	frac := pos - float64(i)
+
+	x0, y0 := robinsonTable[i][0], robinsonTable[i][1]
+	x1, y1 := robinsonTable[i+1][0], robinsonTable[i+1][1]
+
+	return x0 + (x1-x0)*frac, y0 + (y1-y0)*frac
+}
+
+const (
+	naturalEarthA0 = 0.8707
+	naturalEarthA1 = -0.131979
+	naturalEarthA2 = -0.013791
+	naturalEarthA3 = 0.003971
+	naturalEarthA4 = -0.001529
+	naturalEarthB0 = 1.007226
+	naturalEarthB1 = 0.015085
+	naturalEarthB2 = -0.044475
+	naturalEarthB3 = 0.028874
+	naturalEarthB4 = -0.005916
+	naturalEarthC0 = naturalEarthB0
+	naturalEarthC1 = 3 * naturalEarthB1
+	naturalEarthC2 = 7 * naturalEarthB2
+	naturalEarthC3 = 9 * naturalEarthB3
+	naturalEarthC4 = 11 * naturalEarthB4
+)
+
+type naturalEarthProjection struct{}
+
+func (naturalEarthProjection) Forward(lng, lat float64) (x, y float64) {
+	lambda := degToRadians(lng)
+	phi := degToRadians(lat)
+	phi2 := phi * phi
+	phi4 := phi2 * phi2
+
+	x = RE * lambda * (naturalEarthA0 + phi2*(naturalEarthA1+phi2*(naturalEarthA2+phi4*phi2*(naturalEarthA3+phi2*naturalEarthA4))))
+	y = RE * phi * (naturalEarthB0 + phi2*(naturalEarthB1+phi4*(naturalEarthB2+naturalEarthB3*phi2+naturalEarthB4*phi4)))
+
+	return
+}
+
+func (naturalEarthProjection) Inverse(x, y float64) (lng, lat float64) {
+	yr := y / RE
+	phi := yr
+
+	var phi2, phi4 float64
+	const maxIterations = 25
+	for i := 0; i < maxIterations; i++ {
+		phi2 = phi * phi
+		phi4 = phi2 * phi2
+		delta := (phi*(naturalEarthB0+phi2*(naturalEarthB1+phi4*(naturalEarthB2+naturalEarthB3*phi2+naturalEarthB4*phi4))) - yr) /
+			(naturalEarthC0 + phi2*(naturalEarthC1+phi4*(naturalEarthC2+naturalEarthC3*phi2+naturalEarthC4*phi4)))
+		phi -= delta
+		if math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+
+	phi2 = phi * phi
+	phi4 = phi2 * phi2
+	lambda := (x / RE) / (naturalEarthA0 + phi2*(naturalEarthA1+phi2*(naturalEarthA2+phi4*phi2*(naturalEarthA3+phi2*naturalEarthA4))))
+
+	lng = radToDegrees(lambda)
+	lat = radToDegrees(phi)
+
+	return
+}
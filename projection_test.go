@@ -0,0 +1,73 @@
+package mercantile
+
+import (
+	"math"
+	"testing"
+)
+
+func assertRoundTrip(t *testing.T, projection Projection, lng, lat float64) {
+	t.Helper()
+
+	x, y := projection.Forward(lng, lat)
+	gotLng, gotLat := projection.Inverse(x, y)
+
+	if math.Abs(gotLng-lng) > 1e-6 {
+		t.Errorf("lng round-trip: got %v, expected %v", gotLng, lng)
+	}
+	if math.Abs(gotLat-lat) > 1e-6 {
+		t.Errorf("lat round-trip: got %v, expected %v", gotLat, lat)
+	}
+}
+
+func TestXyDefaultsToWebMercator(t *testing.T) {
+	lng, lat := -9.140625, 53.33087298301705
+
+	x, y := Xy(lng, lat)
+	wx, wy := Xy(lng, lat, WebMercator)
+
+	AssertEqual(t, x, wx)
+	AssertEqual(t, y, wy)
+}
+
+func TestXyBoundsDefaultsToWebMercator(t *testing.T) {
+	testTile := Tile{486, 332, 10}
+
+	result := XyBounds(testTile)
+	wresult := XyBounds(testTile, WebMercator)
+
+	AssertEqual(t, result, wresult)
+}
+
+func TestEPSG4326RoundTrip(t *testing.T) {
+	assertRoundTrip(t, EPSG4326, -9.140625, 53.33087298301705)
+	assertRoundTrip(t, EPSG4326, 179.9, -45.0)
+}
+
+func TestEqualEarthRoundTrip(t *testing.T) {
+	assertRoundTrip(t, EqualEarth, -9.140625, 53.33087298301705)
+	assertRoundTrip(t, EqualEarth, 100.0, -30.0)
+	assertRoundTrip(t, EqualEarth, 0.0, 0.0)
+}
+
+func TestRobinsonRoundTrip(t *testing.T) {
+	assertRoundTrip(t, Robinson, -9.140625, 53.33087298301705)
+	assertRoundTrip(t, Robinson, 100.0, -30.0)
+}
+
+func TestNaturalEarthRoundTrip(t *testing.T) {
+	assertRoundTrip(t, NaturalEarth, -9.140625, 53.33087298301705)
+	assertRoundTrip(t, NaturalEarth, 100.0, -30.0)
+}
+
+func TestXyBoundsNonMercatorProjection(t *testing.T) {
+	testTile := Tile{486, 332, 10}
+
+	bounds := XyBounds(testTile, EqualEarth)
+
+	if bounds.MinX >= bounds.MaxX {
+		t.Errorf("expected MinX < MaxX, got %v, %v", bounds.MinX, bounds.MaxX)
+	}
+	if bounds.MinY >= bounds.MaxY {
+		t.Errorf("expected MinY < MaxY, got %v, %v", bounds.MinY, bounds.MaxY)
+	}
+}